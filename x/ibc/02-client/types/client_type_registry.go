@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+// ClientStateConstructor returns a zero-value ClientState for a registered
+// light client type, for UnmarshalClientState (or a keeper) to decode a
+// concrete ClientState into without hardcoding which light client backend
+// is in use.
+type ClientStateConstructor func() clientexported.ClientState
+
+// clientTypeCodecs maps a ClientType to the RegisterCodec function of the
+// sub-module that implements it (e.g. clientexported.Tendermint ->
+// 07-tendermint/types.RegisterCodec). clientStateConstructors maps it to a
+// zero-value ClientState constructor. Together these are the codec and
+// constructor registration plumbing that lets MsgCreateClient dispatch on
+// ClientType (via UnmarshalClientState below) without hardcoding the
+// Tendermint implementation: a third party adds an entry here, from their
+// own package's init(), instead of patching core. There is no 02-client
+// keeper or CLI in this source tree to call UnmarshalClientState from yet
+// (this tree only carries the light-client types packages, not the IBC
+// keeper/handler/CLI layer); wiring those up is a separate, larger change.
+var (
+	clientTypeCodecs        = map[clientexported.ClientType]func(*codec.Codec){}
+	clientStateConstructors = map[clientexported.ClientType]ClientStateConstructor{}
+)
+
+// RegisterClientType registers a light client sub-module's RegisterCodec
+// function under its ClientType. Sub-modules call this from their own
+// codec.go `init()`, so that importing the sub-module package (as the app's
+// codec.go already must, to reference its ClientState type) is enough to
+// make it available to the shared registry.
+func RegisterClientType(clientType clientexported.ClientType, registerCodec func(*codec.Codec)) {
+	clientTypeCodecs[clientType] = registerCodec
+}
+
+// RegisterClientStateConstructor registers the zero-value ClientState
+// constructor for a light client type.
+func RegisterClientStateConstructor(clientType clientexported.ClientType, constructor ClientStateConstructor) {
+	clientStateConstructors[clientType] = constructor
+}
+
+// GetClientStateConstructor returns the registered zero-value ClientState
+// constructor for clientType, or false if no light-client package has
+// registered one.
+func GetClientStateConstructor(clientType clientexported.ClientType) (ClientStateConstructor, bool) {
+	constructor, ok := clientStateConstructors[clientType]
+	return constructor, ok
+}
+
+// UnmarshalClientState decodes a concrete ClientState of the given
+// ClientType from bz. This is the dispatch a MsgCreateClient handler needs:
+// the message carries a ClientType but not a concrete Go type, so the
+// handler asks the registry for the matching zero-value ClientState and
+// decodes bz into it instead of hardcoding a single light-client backend.
+func UnmarshalClientState(cdc *codec.Codec, clientType clientexported.ClientType, bz []byte) (clientexported.ClientState, error) {
+	constructor, ok := GetClientStateConstructor(clientType)
+	if !ok {
+		return nil, fmt.Errorf("no ClientState constructor registered for client type %d", clientType)
+	}
+
+	clientState := constructor()
+	if err := cdc.UnmarshalBinaryBare(bz, &clientState); err != nil {
+		return nil, err
+	}
+
+	return clientState, nil
+}
+
+// RegisterCodec registers every light client type that has called
+// RegisterClientType on cdc. It replaces a hardcoded call into
+// 07-tendermint/types alone, and is intended to be called once by the IBC
+// module's top-level codec.go.
+func RegisterCodec(cdc *codec.Codec) {
+	for _, registerCodec := range clientTypeCodecs {
+		registerCodec(cdc)
+	}
+}
@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	solomachinetypes "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+	tendermint "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+)
+
+// TestGetClientStateConstructor checks that importing a light client
+// sub-module (as the app's codec.go must, to reference its ClientState
+// type) is enough for its zero-value ClientState constructor to show up in
+// the shared registry, for every sub-module that has registered one via its
+// own init().
+func TestGetClientStateConstructor(t *testing.T) {
+	constructor, ok := clienttypes.GetClientStateConstructor(clientexported.Tendermint)
+	require.True(t, ok)
+	require.IsType(t, tendermint.ClientState{}, constructor())
+
+	constructor, ok = clienttypes.GetClientStateConstructor(clientexported.SoloMachine)
+	require.True(t, ok)
+	require.IsType(t, solomachinetypes.ClientState{}, constructor())
+
+	_, ok = clienttypes.GetClientStateConstructor(clientexported.ClientType(255))
+	require.False(t, ok)
+}
+
+// TestUnmarshalClientState checks that UnmarshalClientState dispatches to
+// the right concrete ClientState for a registered ClientType, the way a
+// MsgCreateClient handler would use it to decode a client state whose
+// concrete Go type it does not know ahead of time.
+func TestUnmarshalClientState(t *testing.T) {
+	solomachineClientState := solomachinetypes.NewClientState(
+		"solomachineA",
+		solomachinetypes.NewConsensusState(ed25519.GenPrivKey().PubKey(), "diversifier", 10),
+		1,
+	)
+	bz, err := solomachinetypes.SubModuleCdc.MarshalBinaryBare(solomachineClientState)
+	require.NoError(t, err)
+
+	clientState, err := clienttypes.UnmarshalClientState(solomachinetypes.SubModuleCdc, clientexported.SoloMachine, bz)
+	require.NoError(t, err)
+	require.Equal(t, solomachineClientState, clientState)
+
+	_, err = clienttypes.UnmarshalClientState(solomachinetypes.SubModuleCdc, clientexported.ClientType(255), bz)
+	require.Error(t, err)
+}
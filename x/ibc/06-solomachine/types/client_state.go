@@ -0,0 +1,226 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// SignBytes is the value a solo machine signs over to prove a path/data pair
+// at a given sequence. All "proofs" submitted to a solo machine client are
+// just a marshaled Signature over these bytes.
+type SignBytes struct {
+	Sequence    uint64 `json:"sequence" yaml:"sequence"`
+	Timestamp   uint64 `json:"timestamp" yaml:"timestamp"`
+	Diversifier string `json:"diversifier" yaml:"diversifier"`
+	Path        []byte `json:"path" yaml:"path"`
+	Data        []byte `json:"data" yaml:"data"`
+}
+
+// VerifyClientConsensusState verifies a signature of the consensus state of
+// the solo machine client stored on the target machine.
+func (cs ClientState) VerifyClientConsensusState(
+	cdc *codec.Codec,
+	_ commitmentexported.Root,
+	height uint64,
+	counterpartyClientIdentifier string,
+	consensusHeight uint64,
+	_ commitmentexported.Prefix,
+	proof commitmentexported.Proof,
+	consensusState clientexported.ConsensusState,
+) error {
+	path := "clients/" + counterpartyClientIdentifier + "/" + ibctypes.ConsensusStatePath(consensusHeight)
+
+	bz, err := cdc.MarshalBinaryBare(consensusState)
+	if err != nil {
+		return err
+	}
+
+	return cs.verifySignature(height, []byte(path), bz, proof)
+}
+
+// VerifyClientState verifies a signature of the client state of the
+// counterparty client stored on the target machine.
+func (cs ClientState) VerifyClientState(
+	cdc *codec.Codec,
+	_ commitmentexported.Root,
+	height uint64,
+	counterpartyClientIdentifier string,
+	_ commitmentexported.Prefix,
+	proof commitmentexported.Proof,
+	clientState clientexported.ClientState,
+) error {
+	path := "clients/" + counterpartyClientIdentifier + "/" + ibctypes.ClientStatePath()
+
+	bz, err := cdc.MarshalBinaryBare(clientState)
+	if err != nil {
+		return err
+	}
+
+	return cs.verifySignature(height, []byte(path), bz, proof)
+}
+
+// VerifyConnectionState verifies a signature of the connection state of the
+// specified connection end stored on the target machine.
+func (cs ClientState) VerifyConnectionState(
+	cdc *codec.Codec,
+	height uint64,
+	_ commitmentexported.Prefix,
+	proof commitmentexported.Proof,
+	connectionID string,
+	connectionEnd connectionexported.ConnectionI,
+	_ clientexported.ConsensusState,
+) error {
+	bz, err := cdc.MarshalBinaryBare(connectionEnd)
+	if err != nil {
+		return err
+	}
+
+	return cs.verifySignature(height, []byte(ibctypes.ConnectionPath(connectionID)), bz, proof)
+}
+
+// VerifyChannelState verifies a signature of the channel state of the
+// specified channel end, under the specified port, stored on the target
+// machine.
+func (cs ClientState) VerifyChannelState(
+	cdc *codec.Codec,
+	height uint64,
+	_ commitmentexported.Prefix,
+	proof commitmentexported.Proof,
+	portID,
+	channelID string,
+	channel channelexported.ChannelI,
+	_ clientexported.ConsensusState,
+) error {
+	bz, err := cdc.MarshalBinaryBare(channel)
+	if err != nil {
+		return err
+	}
+
+	return cs.verifySignature(height, []byte(ibctypes.ChannelPath(portID, channelID)), bz, proof)
+}
+
+// VerifyPacketCommitment verifies a signature of an outgoing packet
+// commitment at the specified port, specified channel, and specified
+// sequence.
+func (cs ClientState) VerifyPacketCommitment(
+	height uint64,
+	_ commitmentexported.Prefix,
+	proof commitmentexported.Proof,
+	portID,
+	channelID string,
+	sequence uint64,
+	commitmentBytes []byte,
+	_ clientexported.ConsensusState,
+) error {
+	path := ibctypes.PacketCommitmentPath(portID, channelID, sequence)
+	return cs.verifySignature(height, []byte(path), commitmentBytes, proof)
+}
+
+// VerifyPacketAcknowledgement verifies a signature of an incoming packet
+// acknowledgement at the specified port, specified channel, and specified
+// sequence.
+func (cs ClientState) VerifyPacketAcknowledgement(
+	height uint64,
+	_ commitmentexported.Prefix,
+	proof commitmentexported.Proof,
+	portID,
+	channelID string,
+	sequence uint64,
+	acknowledgement []byte,
+	_ clientexported.ConsensusState,
+) error {
+	path := ibctypes.PacketAcknowledgementPath(portID, channelID, sequence)
+	return cs.verifySignature(height, []byte(path), acknowledgement, proof)
+}
+
+// VerifyPacketAcknowledgementAbsence verifies a signature of the absence of
+// an incoming packet acknowledgement at the specified port, specified
+// channel, and specified sequence.
+func (cs ClientState) VerifyPacketAcknowledgementAbsence(
+	height uint64,
+	_ commitmentexported.Prefix,
+	proof commitmentexported.Proof,
+	portID,
+	channelID string,
+	sequence uint64,
+	_ clientexported.ConsensusState,
+) error {
+	path := ibctypes.PacketAcknowledgementPath(portID, channelID, sequence)
+	return cs.verifySignature(height, []byte(path), nil, proof)
+}
+
+// VerifyNextSequenceRecv verifies a signature of the next sequence number to
+// be received of the specified channel at the specified port.
+func (cs ClientState) VerifyNextSequenceRecv(
+	height uint64,
+	_ commitmentexported.Prefix,
+	proof commitmentexported.Proof,
+	portID,
+	channelID string,
+	nextSequenceRecv uint64,
+	_ clientexported.ConsensusState,
+) error {
+	path := ibctypes.NextSequenceRecvPath(portID, channelID)
+	return cs.verifySignature(height, []byte(path), sdk.Uint64ToBigEndian(nextSequenceRecv), proof)
+}
+
+// VerifyUpgrade is not supported by the solo machine client, since a solo
+// machine has no notion of a hard fork to upgrade across.
+func (cs ClientState) VerifyUpgrade(
+	_ *codec.Codec,
+	_ uint64,
+	_ clientexported.ClientState,
+	_ clientexported.ConsensusState,
+	_, _ commitmentexported.Proof,
+	_ commitmentexported.Root,
+) error {
+	return sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade solo machine client")
+}
+
+// verifySignature checks that proof decodes to a SignatureAndData that is a
+// valid signature, from the client's currently trusted public key, over a
+// SignBytes value built from sequence, path and data. It also enforces that
+// the client is not frozen at or before the given sequence.
+func (cs ClientState) verifySignature(
+	sequence uint64,
+	path, data []byte,
+	proof commitmentexported.Proof,
+) error {
+	if cs.GetLatestHeight() < sequence {
+		return sdkerrors.Wrap(ibctypes.ErrInvalidHeight, "client state sequence < proof sequence")
+	}
+
+	if cs.IsFrozen() && cs.FrozenSequence <= sequence {
+		return clienttypes.ErrClientFrozen
+	}
+
+	if proof == nil {
+		return sdkerrors.Wrap(ErrInvalidSignatureData, "proof cannot be empty")
+	}
+
+	if cs.ConsensusState.PublicKey == nil {
+		return sdkerrors.Wrap(ErrInvalidPublicKey, "public key cannot be nil")
+	}
+
+	sigData, ok := proof.(SignatureAndData)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidSignatureData, "proof type %T, expected %T", proof, SignatureAndData{})
+	}
+
+	signBytes := SignBytes{
+		Sequence:    sequence,
+		Timestamp:   sigData.Timestamp,
+		Diversifier: cs.ConsensusState.Diversifier,
+		Path:        path,
+		Data:        data,
+	}
+
+	return verifySignatureAndData(cs, signBytes, sigData.Signature)
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+func TestClientStateVerifyPacketCommitment(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	const (
+		sequence    = uint64(1)
+		portID      = "transfer"
+		channelID   = "channel-0"
+		packetSeq   = uint64(1)
+		diversifier = "diversifier"
+		timestamp   = uint64(10)
+	)
+
+	cs := types.NewClientState(
+		"solomachineA",
+		types.NewConsensusState(pubKey, diversifier, timestamp),
+		sequence,
+	)
+
+	data := []byte("commitment-bytes")
+	path := []byte(ibctypes.PacketCommitmentPath(portID, channelID, packetSeq))
+
+	signBytes := types.SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		Path:        path,
+		Data:        data,
+	}
+	signBz, err := types.SubModuleCdc.MarshalBinaryBare(signBytes)
+	require.NoError(t, err)
+
+	sig, err := privKey.Sign(signBz)
+	require.NoError(t, err)
+
+	validProof := types.SignatureAndData{Signature: sig, Data: data, Timestamp: timestamp}
+	err = cs.VerifyPacketCommitment(sequence, nil, validProof, portID, channelID, packetSeq, data, nil)
+	require.NoError(t, err, "a signature over the exact data committed to must verify")
+
+	forgedProof := types.SignatureAndData{Signature: sig, Data: []byte("forged-data"), Timestamp: timestamp}
+	err = cs.VerifyPacketCommitment(sequence, nil, forgedProof, portID, channelID, packetSeq, []byte("forged-data"), nil)
+	require.Error(t, err, "a signature over different data must not verify against the forged data")
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// SubModuleCdc is the codec used by the solo machine client sub-module to
+// (de)serialize its concrete ClientState, ConsensusState, Header,
+// Misbehaviour, SignBytes, SignatureAndData and MsgCreateClient values,
+// e.g. for MsgCreateClient's GetSignBytes.
+var SubModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(SubModuleCdc)
+	clienttypes.RegisterClientType(clientexported.SoloMachine, RegisterCodec)
+	clienttypes.RegisterClientStateConstructor(clientexported.SoloMachine, func() clientexported.ClientState {
+		return ClientState{}
+	})
+}
+
+// RegisterCodec registers the solo machine light client concrete types on
+// the given codec. It is called for this sub-module's own SubModuleCdc and,
+// via the 02-client registry's RegisterCodec, for any codec that function is
+// called on, so that instantiating a solo machine client does not require
+// patching 02-client directly: a third party light client package just
+// needs to register itself with the 02-client registry the same way, from
+// its own init().
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(ClientState{}, "ibc/client/solomachine/ClientState", nil)
+	cdc.RegisterConcrete(ConsensusState{}, "ibc/client/solomachine/ConsensusState", nil)
+	cdc.RegisterConcrete(Header{}, "ibc/client/solomachine/Header", nil)
+	cdc.RegisterConcrete(Misbehaviour{}, "ibc/client/solomachine/Misbehaviour", nil)
+	cdc.RegisterConcrete(SignatureAndData{}, "ibc/client/solomachine/SignatureAndData", nil)
+	cdc.RegisterConcrete(MsgCreateClient{}, "ibc/client/solomachine/MsgCreateClient", nil)
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SubModuleName is the solo machine light client sub-module's name.
+const SubModuleName = "client-solomachine"
+
+// Solo machine sentinel errors
+var (
+	ErrInvalidPublicKey      = sdkerrors.Register(SubModuleName, 2, "invalid public key")
+	ErrInvalidSequence       = sdkerrors.Register(SubModuleName, 3, "invalid sequence")
+	ErrInvalidSignatureData  = sdkerrors.Register(SubModuleName, 4, "invalid signature data")
+	ErrSignatureVerification = sdkerrors.Register(SubModuleName, 5, "signature verification failed")
+	ErrInvalidClientID       = sdkerrors.Register(SubModuleName, 6, "invalid client id")
+)
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+)
+
+var _ clientexported.Misbehaviour = Misbehaviour{}
+
+// Misbehaviour defines misbehaviour for a solo machine: two signatures, by
+// the same trusted public key, over conflicting data at the same sequence.
+// ClientID is carried explicitly rather than derived, since SignatureAndData
+// says nothing about which registered solo machine client signed it.
+type Misbehaviour struct {
+	ClientID     string           `json:"client_id" yaml:"client_id"`
+	Sequence     uint64           `json:"sequence" yaml:"sequence"`
+	SignatureOne SignatureAndData `json:"signature_one" yaml:"signature_one"`
+	SignatureTwo SignatureAndData `json:"signature_two" yaml:"signature_two"`
+}
+
+var _ commitmentexported.Proof = SignatureAndData{}
+
+// SignatureAndData contains a signature and the data the solo machine signed.
+// It doubles as this client's commitmentexported.Proof implementation: a
+// solo machine proof is just a signature, not a Merkle membership proof.
+type SignatureAndData struct {
+	Signature []byte `json:"signature" yaml:"signature"`
+	Data      []byte `json:"data" yaml:"data"`
+	Timestamp uint64 `json:"timestamp" yaml:"timestamp"`
+}
+
+// VerifyMembership and VerifyNonMembership exist only so SignatureAndData
+// satisfies the shared commitmentexported.Proof interface used across every
+// light client backend. A solo machine never checks Merkle membership; the
+// actual check is the signature verification performed in verifySignature
+// before a proof ever reaches these.
+func (SignatureAndData) VerifyMembership(_ commitmentexported.Root, _ string, _ []byte) error {
+	return nil
+}
+
+func (SignatureAndData) VerifyNonMembership(_ commitmentexported.Root, _ string) error {
+	return nil
+}
+
+// ClientType is solo machine.
+func (misbehaviour Misbehaviour) ClientType() clientexported.ClientType {
+	return clientexported.SoloMachine
+}
+
+// GetClientID returns the ID of the client that committed the misbehaviour.
+func (misbehaviour Misbehaviour) GetClientID() string {
+	return misbehaviour.ClientID
+}
+
+// GetHeight returns the sequence at which the misbehaviour occurred.
+func (misbehaviour Misbehaviour) GetHeight() uint64 {
+	return misbehaviour.Sequence
+}
+
+// ValidateBasic checks that the two signatures actually conflict: they must
+// share a client id and sequence, but sign over different data, otherwise
+// the "evidence" does not demonstrate double-signing at all.
+func (misbehaviour Misbehaviour) ValidateBasic() error {
+	if misbehaviour.ClientID == "" {
+		return sdkerrors.Wrap(ErrInvalidClientID, "client id cannot be empty")
+	}
+	if misbehaviour.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidSequence, "sequence must be positive")
+	}
+	if len(misbehaviour.SignatureOne.Signature) == 0 || len(misbehaviour.SignatureTwo.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignatureData, "signature cannot be empty")
+	}
+	if bytes.Equal(misbehaviour.SignatureOne.Data, misbehaviour.SignatureTwo.Data) {
+		return sdkerrors.Wrap(ErrInvalidSignatureData, "signatures must sign over different data")
+	}
+
+	return nil
+}
+
+// CheckMisbehaviourAndUpdateState checks that misbehaviour is valid evidence
+// that the solo machine's trusted public key signed two different messages
+// at the same sequence, and if so freezes the client at that sequence.
+func (cs ClientState) CheckMisbehaviourAndUpdateState(
+	ctx sdk.Context,
+	cdc *codec.Codec,
+	store sdk.KVStore,
+	misbehaviour clientexported.Misbehaviour,
+) (clientexported.ClientState, error) {
+	smMisbehaviour, ok := misbehaviour.(Misbehaviour)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidSignatureData, "misbehaviour type %T, expected %T", misbehaviour, Misbehaviour{})
+	}
+
+	if smMisbehaviour.ClientID != cs.ID {
+		return nil, sdkerrors.Wrapf(ErrInvalidClientID, "client id mismatch: got %s, expected %s", smMisbehaviour.ClientID, cs.ID)
+	}
+
+	if err := smMisbehaviour.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if cs.IsFrozen() {
+		return nil, clienttypes.ErrClientFrozen
+	}
+
+	if cs.ConsensusState.PublicKey == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidPublicKey, "public key cannot be nil")
+	}
+
+	signBytesOne := SignBytes{
+		Sequence:    smMisbehaviour.Sequence,
+		Timestamp:   smMisbehaviour.SignatureOne.Timestamp,
+		Diversifier: cs.ConsensusState.Diversifier,
+		Data:        smMisbehaviour.SignatureOne.Data,
+	}
+	signBytesTwo := SignBytes{
+		Sequence:    smMisbehaviour.Sequence,
+		Timestamp:   smMisbehaviour.SignatureTwo.Timestamp,
+		Diversifier: cs.ConsensusState.Diversifier,
+		Data:        smMisbehaviour.SignatureTwo.Data,
+	}
+
+	if err := verifySignatureAndData(cs, signBytesOne, smMisbehaviour.SignatureOne.Signature); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to verify signature one")
+	}
+	if err := verifySignatureAndData(cs, signBytesTwo, smMisbehaviour.SignatureTwo.Signature); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to verify signature two")
+	}
+
+	cs.FrozenSequence = smMisbehaviour.Sequence
+
+	return cs, nil
+}
+
+// verifySignatureAndData checks that signature is a valid signature, from
+// the client's currently trusted public key, over the marshaled SignBytes.
+func verifySignatureAndData(cs ClientState, signBytes SignBytes, signature []byte) error {
+	bz, err := SubModuleCdc.MarshalBinaryBare(signBytes)
+	if err != nil {
+		return err
+	}
+
+	if !cs.ConsensusState.PublicKey.VerifyBytes(bz, signature) {
+		return sdkerrors.Wrap(ErrSignatureVerification, "failed to verify signature")
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+)
+
+func TestMisbehaviourValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name         string
+		misbehaviour types.Misbehaviour
+		expectPass   bool
+	}{
+		{
+			"valid misbehaviour",
+			types.Misbehaviour{
+				ClientID:     "solomachineA",
+				Sequence:     1,
+				SignatureOne: types.SignatureAndData{Signature: []byte("sigA"), Data: []byte("dataA")},
+				SignatureTwo: types.SignatureAndData{Signature: []byte("sigB"), Data: []byte("dataB")},
+			},
+			true,
+		},
+		{
+			"empty client id",
+			types.Misbehaviour{
+				ClientID:     "",
+				Sequence:     1,
+				SignatureOne: types.SignatureAndData{Signature: []byte("sigA"), Data: []byte("dataA")},
+				SignatureTwo: types.SignatureAndData{Signature: []byte("sigB"), Data: []byte("dataB")},
+			},
+			false,
+		},
+		{
+			"zero sequence",
+			types.Misbehaviour{
+				ClientID:     "solomachineA",
+				Sequence:     0,
+				SignatureOne: types.SignatureAndData{Signature: []byte("sigA"), Data: []byte("dataA")},
+				SignatureTwo: types.SignatureAndData{Signature: []byte("sigB"), Data: []byte("dataB")},
+			},
+			false,
+		},
+		{
+			"empty signature",
+			types.Misbehaviour{
+				ClientID:     "solomachineA",
+				Sequence:     1,
+				SignatureOne: types.SignatureAndData{Signature: []byte{}, Data: []byte("dataA")},
+				SignatureTwo: types.SignatureAndData{Signature: []byte("sigB"), Data: []byte("dataB")},
+			},
+			false,
+		},
+		{
+			"signatures over identical data (not a conflict)",
+			types.Misbehaviour{
+				ClientID:     "solomachineA",
+				Sequence:     1,
+				SignatureOne: types.SignatureAndData{Signature: []byte("sigA"), Data: []byte("dataA")},
+				SignatureTwo: types.SignatureAndData{Signature: []byte("sigB"), Data: []byte("dataA")},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.misbehaviour.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+const (
+	misbehaviourDiversifier = "diversifier"
+	misbehaviourTimestamp   = uint64(10)
+	misbehaviourSequence    = uint64(1)
+)
+
+// signMisbehaviourData builds the SignBytes a solo machine would sign over
+// for the given data at misbehaviourSequence, and signs it with signKey.
+func signMisbehaviourData(t *testing.T, signKey crypto.PrivKey, data []byte) types.SignatureAndData {
+	t.Helper()
+
+	signBytes := types.SignBytes{
+		Sequence:    misbehaviourSequence,
+		Timestamp:   misbehaviourTimestamp,
+		Diversifier: misbehaviourDiversifier,
+		Data:        data,
+	}
+	bz, err := types.SubModuleCdc.MarshalBinaryBare(signBytes)
+	require.NoError(t, err)
+
+	sig, err := signKey.Sign(bz)
+	require.NoError(t, err)
+
+	return types.SignatureAndData{Signature: sig, Data: data, Timestamp: misbehaviourTimestamp}
+}
+
+// TestCheckMisbehaviourAndUpdateStateEquivocation checks that misbehaviour
+// genuinely signed by the client's trusted public key, over two different
+// data values at the same sequence, freezes the client at that sequence.
+func TestCheckMisbehaviourAndUpdateStateEquivocation(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+
+	cs := types.NewClientState(
+		"solomachineA",
+		types.NewConsensusState(privKey.PubKey(), misbehaviourDiversifier, misbehaviourTimestamp),
+		misbehaviourSequence,
+	)
+
+	misbehaviour := types.Misbehaviour{
+		ClientID:     "solomachineA",
+		Sequence:     misbehaviourSequence,
+		SignatureOne: signMisbehaviourData(t, privKey, []byte("dataA")),
+		SignatureTwo: signMisbehaviourData(t, privKey, []byte("dataB")),
+	}
+
+	newClientState, err := cs.CheckMisbehaviourAndUpdateState(sdk.Context{}, nil, nil, misbehaviour)
+	require.NoError(t, err)
+
+	smClientState, ok := newClientState.(types.ClientState)
+	require.True(t, ok)
+	require.True(t, smClientState.IsFrozen())
+	require.Equal(t, misbehaviourSequence, smClientState.FrozenSequence)
+}
+
+// TestCheckMisbehaviourAndUpdateStateInvalidSignature checks that
+// misbehaviour carrying a signature from a key other than the client's
+// currently trusted public key is rejected rather than freezing the client.
+func TestCheckMisbehaviourAndUpdateStateInvalidSignature(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	forgedKey := ed25519.GenPrivKey()
+
+	cs := types.NewClientState(
+		"solomachineA",
+		types.NewConsensusState(privKey.PubKey(), misbehaviourDiversifier, misbehaviourTimestamp),
+		misbehaviourSequence,
+	)
+
+	misbehaviour := types.Misbehaviour{
+		ClientID:     "solomachineA",
+		Sequence:     misbehaviourSequence,
+		SignatureOne: signMisbehaviourData(t, forgedKey, []byte("dataA")),
+		SignatureTwo: signMisbehaviourData(t, privKey, []byte("dataB")),
+	}
+
+	_, err := cs.CheckMisbehaviourAndUpdateState(sdk.Context{}, nil, nil, misbehaviour)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to verify signature one")
+}
+
+// TestCheckMisbehaviourAndUpdateStateClientIDMismatch checks that
+// misbehaviour submitted for a different client id than the one being
+// checked is rejected.
+func TestCheckMisbehaviourAndUpdateStateClientIDMismatch(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+
+	cs := types.NewClientState(
+		"solomachineA",
+		types.NewConsensusState(privKey.PubKey(), misbehaviourDiversifier, misbehaviourTimestamp),
+		misbehaviourSequence,
+	)
+
+	misbehaviour := types.Misbehaviour{
+		ClientID:     "solomachineB",
+		Sequence:     misbehaviourSequence,
+		SignatureOne: signMisbehaviourData(t, privKey, []byte("dataA")),
+		SignatureTwo: signMisbehaviourData(t, privKey, []byte("dataB")),
+	}
+
+	_, err := cs.CheckMisbehaviourAndUpdateState(sdk.Context{}, nil, nil, misbehaviour)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "client id mismatch")
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// TypeMsgCreateClient is the type name of MsgCreateClient.
+const TypeMsgCreateClient = "create_client"
+
+var _ sdk.Msg = MsgCreateClient{}
+
+// MsgCreateClient defines a message to create a solo machine client.
+type MsgCreateClient struct {
+	ClientID    string         `json:"client_id" yaml:"client_id"`
+	Sequence    uint64         `json:"sequence" yaml:"sequence"`
+	PublicKey   crypto.PubKey  `json:"public_key" yaml:"public_key"`
+	Diversifier string         `json:"diversifier" yaml:"diversifier"`
+	Timestamp   uint64         `json:"timestamp" yaml:"timestamp"`
+	Signer      sdk.AccAddress `json:"signer" yaml:"signer"`
+}
+
+// NewMsgCreateClient creates a new MsgCreateClient instance
+func NewMsgCreateClient(
+	id string, sequence uint64, publicKey crypto.PubKey, diversifier string, timestamp uint64,
+	signer sdk.AccAddress,
+) MsgCreateClient {
+	return MsgCreateClient{
+		ClientID:    id,
+		Sequence:    sequence,
+		PublicKey:   publicKey,
+		Diversifier: diversifier,
+		Timestamp:   timestamp,
+		Signer:      signer,
+	}
+}
+
+// GetClientID implements the same accessor 07-tendermint's MsgCreateClient
+// exposes.
+func (msg MsgCreateClient) GetClientID() string {
+	return msg.ClientID
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateClient) Route() string {
+	return ibctypes.RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgCreateClient) Type() string {
+	return TypeMsgCreateClient
+}
+
+// ValidateBasic implements sdk.Msg. It runs the same checks as
+// ClientState.Validate against the client state the message would create,
+// mirroring 07-tendermint's MsgCreateClient.ValidateBasic.
+func (msg MsgCreateClient) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if msg.ClientID == "" {
+		return sdkerrors.Wrap(ErrInvalidClientID, "client id cannot be empty")
+	}
+
+	consensusState := NewConsensusState(msg.PublicKey, msg.Diversifier, msg.Timestamp)
+	clientState := NewClientState(msg.ClientID, consensusState, msg.Sequence)
+	return clientState.Validate()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
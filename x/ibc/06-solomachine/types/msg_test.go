@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+)
+
+func TestMsgCreateClientValidateBasic(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	signer := sdk.AccAddress("testaddr1")
+
+	testCases := []struct {
+		name           string
+		msg            types.MsgCreateClient
+		expectInErrMsg string
+	}{
+		{
+			"valid msg",
+			types.NewMsgCreateClient("solomachineA", 1, pubKey, "diversifier", 10, signer),
+			"",
+		},
+		{
+			"empty signer",
+			types.NewMsgCreateClient("solomachineA", 1, pubKey, "diversifier", 10, sdk.AccAddress{}),
+			"invalid address",
+		},
+		{
+			"empty client id",
+			types.NewMsgCreateClient("", 1, pubKey, "diversifier", 10, signer),
+			"client id",
+		},
+		{
+			"zero sequence",
+			types.NewMsgCreateClient("solomachineA", 0, pubKey, "diversifier", 10, signer),
+			"sequence must be positive",
+		},
+		{
+			"nil public key",
+			types.NewMsgCreateClient("solomachineA", 1, nil, "diversifier", 10, signer),
+			"public key cannot be nil",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectInErrMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectInErrMsg)
+		})
+	}
+}
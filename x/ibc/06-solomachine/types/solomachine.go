@@ -0,0 +1,116 @@
+package types
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+var _ clientexported.ClientState = ClientState{}
+
+// ClientState of a solo machine. Unlike the Tendermint light client, a solo
+// machine is not a Merkle-proof-backed chain: its "latest height" is simply
+// a monotonically increasing sequence protected by a single (rotatable)
+// public key, so verification is done by checking a signature over the
+// claimed state rather than a membership proof against a committed root.
+type ClientState struct {
+	// Client ID
+	ID string `json:"id" yaml:"id"`
+	// Sequence number of the solo machine, increases on every committed header
+	Sequence uint64 `json:"sequence" yaml:"sequence"`
+	// Frozen sequence of the solo machine. Non-zero once misbehaviour has
+	// been submitted for this client.
+	FrozenSequence uint64 `json:"frozen_sequence" yaml:"frozen_sequence"`
+	// Consensus state holding the currently trusted public key
+	ConsensusState ConsensusState `json:"consensus_state" yaml:"consensus_state"`
+}
+
+// ConsensusState defines a solo machine consensus state. The consensus state
+// is simply the public key, signing diversifier and timestamp of the most
+// recently committed header; it is intentionally a single trusted key
+// rather than a validator set, since a solo machine has exactly one signer.
+// Diversifier lets the same key sign for more than one client without its
+// signatures being replayable across them.
+type ConsensusState struct {
+	PublicKey   crypto.PubKey `json:"public_key" yaml:"public_key"`
+	Diversifier string        `json:"diversifier" yaml:"diversifier"`
+	Timestamp   uint64        `json:"timestamp" yaml:"timestamp"`
+}
+
+// Header defines a solo machine header. Updating a solo machine client
+// requires a signature, from the currently trusted public key, over the new
+// public key (which may be unchanged) and the new diversifier.
+type Header struct {
+	Sequence       uint64        `json:"sequence" yaml:"sequence"`
+	Timestamp      uint64        `json:"timestamp" yaml:"timestamp"`
+	Signature      []byte        `json:"signature" yaml:"signature"`
+	NewPublicKey   crypto.PubKey `json:"new_public_key" yaml:"new_public_key"`
+	NewDiversifier string        `json:"new_diversifier" yaml:"new_diversifier"`
+}
+
+// NewClientState creates a new ClientState instance.
+func NewClientState(id string, consensusState ConsensusState, sequence uint64) ClientState {
+	return ClientState{
+		ID:             id,
+		Sequence:       sequence,
+		FrozenSequence: 0,
+		ConsensusState: consensusState,
+	}
+}
+
+// NewConsensusState creates a new ConsensusState instance.
+func NewConsensusState(publicKey crypto.PubKey, diversifier string, timestamp uint64) ConsensusState {
+	return ConsensusState{
+		PublicKey:   publicKey,
+		Diversifier: diversifier,
+		Timestamp:   timestamp,
+	}
+}
+
+// GetID returns the solo machine client state identifier.
+func (cs ClientState) GetID() string {
+	return cs.ID
+}
+
+// GetChainID returns an empty string. A solo machine client is not
+// associated with a chain-id.
+func (cs ClientState) GetChainID() string {
+	return ""
+}
+
+// ClientType is solo machine.
+func (cs ClientState) ClientType() clientexported.ClientType {
+	return clientexported.SoloMachine
+}
+
+// GetLatestHeight returns the sequence number as the height.
+func (cs ClientState) GetLatestHeight() uint64 {
+	return cs.Sequence
+}
+
+// GetLatestTimestamp returns the timestamp of the consensus state.
+func (cs ClientState) GetLatestTimestamp() time.Time {
+	return time.Unix(0, int64(cs.ConsensusState.Timestamp))
+}
+
+// IsFrozen returns true if the frozen sequence has been set.
+func (cs ClientState) IsFrozen() bool {
+	return cs.FrozenSequence != 0
+}
+
+// Validate performs a basic validation of the client state fields.
+func (cs ClientState) Validate() error {
+	if cs.ID == "" {
+		return sdkerrors.Wrap(ErrInvalidClientID, "client id cannot be empty")
+	}
+	if cs.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidSequence, "sequence must be positive")
+	}
+	if cs.ConsensusState.PublicKey == nil {
+		return sdkerrors.Wrap(ErrInvalidPublicKey, "public key cannot be nil")
+	}
+	return nil
+}
@@ -1,7 +1,6 @@
 package types
 
 import (
-	"errors"
 	"fmt"
 	"time"
 
@@ -29,49 +28,92 @@ type ClientState struct {
 	TrustingPeriod time.Duration `json:"trusting_period" yaml:"trusting_period"`
 	// Duration of the staking unbonding period
 	UnbondingPeriod time.Duration `json:"unbonding_period" yaml:"unbonding_period"`
+	// Maximum amount of clock drift the submitted headers are allowed to have
+	// against the full node's current time
+	MaxClockDrift time.Duration `json:"max_clock_drift" yaml:"max_clock_drift"`
 	// Block height when the client was frozen due to a misbehaviour
 	FrozenHeight uint64 `json:"frozen_height" yaml:"frozen_height"`
 	// Last Header that was stored by client
 	LastHeader Header `json:"last_header" yaml:"last_header"`
+	// Path at which next upgraded client will be committed. Each element
+	// corresponds to the key for a single CommitmentProof in the chained
+	// proof. VerifyUpgrade proves the upgraded ClientState at
+	// FullClientPath(UpgradePath, ClientStatePath()) and its ConsensusState at
+	// FullClientPath(UpgradePath, ConsensusStatePath(<upgraded client's latest height>)).
+	// For SDK chains this should be the list ["upgrade", "upgradedClient"]
+	UpgradePath []string `json:"upgrade_path" yaml:"upgrade_path"`
 }
 
 // InitializeFromMsg creates a tendermint client state from a CreateClientMsg
 func InitializeFromMsg(
 	msg MsgCreateClient,
 ) (ClientState, error) {
-	return Initialize(msg.GetClientID(), msg.TrustingPeriod, msg.UnbondingPeriod, msg.Header)
+	return Initialize(msg.GetClientID(), msg.TrustingPeriod, msg.UnbondingPeriod, msg.MaxClockDrift, msg.Header, msg.UpgradePath)
 }
 
 // Initialize creates a client state and validates its contents, checking that
 // the provided consensus state is from the same client type.
 func Initialize(
-	id string, trustingPeriod, ubdPeriod time.Duration,
-	header Header,
+	id string, trustingPeriod, ubdPeriod, maxClockDrift time.Duration,
+	header Header, upgradePath []string,
 ) (ClientState, error) {
-	if trustingPeriod >= ubdPeriod {
-		return ClientState{}, errors.New("trusting period should be < unbonding period")
-	}
-
 	clientState := NewClientState(
-		id, trustingPeriod, ubdPeriod, header,
+		id, trustingPeriod, ubdPeriod, maxClockDrift, header, upgradePath,
 	)
+
+	if err := clientState.Validate(); err != nil {
+		return ClientState{}, err
+	}
+
 	return clientState, nil
 }
 
 // NewClientState creates a new ClientState instance
 func NewClientState(
-	id string, trustingPeriod, ubdPeriod time.Duration,
-	header Header,
+	id string, trustingPeriod, ubdPeriod, maxClockDrift time.Duration,
+	header Header, upgradePath []string,
 ) ClientState {
 	return ClientState{
 		ID:              id,
 		TrustingPeriod:  trustingPeriod,
 		UnbondingPeriod: ubdPeriod,
+		MaxClockDrift:   maxClockDrift,
 		LastHeader:      header,
+		UpgradePath:     upgradePath,
 		FrozenHeight:    0,
 	}
 }
 
+// Validate performs a basic validation of the client state fields. It checks
+// that the trusting period, unbonding period and max clock drift are all
+// strictly positive, that the trusting period is shorter than the unbonding
+// period, and that the stored header has a non-empty chain id and a positive
+// height; the header is not otherwise validated. A client state that
+// fails this check must never be persisted, so that it cannot later break
+// header or misbehaviour verification.
+func (cs ClientState) Validate() error {
+	if cs.GetChainID() == "" {
+		return sdkerrors.Wrap(ErrInvalidChainID, "chain id cannot be empty")
+	}
+	if cs.LastHeader.Height <= 0 {
+		return sdkerrors.Wrap(ErrInvalidHeaderHeight, "header height must be positive")
+	}
+	if cs.TrustingPeriod <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidTrustingPeriod, "trusting period must be positive: %s", cs.TrustingPeriod)
+	}
+	if cs.UnbondingPeriod <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidUnbondingPeriod, "unbonding period must be positive: %s", cs.UnbondingPeriod)
+	}
+	if cs.MaxClockDrift <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidMaxClockDrift, "max clock drift must be positive: %s", cs.MaxClockDrift)
+	}
+	if cs.TrustingPeriod >= cs.UnbondingPeriod {
+		return sdkerrors.Wrap(ErrInvalidTrustingPeriod, "trusting period should be < unbonding period")
+	}
+
+	return nil
+}
+
 // GetID returns the tendermint client state identifier.
 func (cs ClientState) GetID() string {
 	return cs.ID
@@ -136,6 +178,94 @@ func (cs ClientState) VerifyClientConsensusState(
 	return nil
 }
 
+// VerifyClientState verifies a proof of the client state of the specified
+// client stored on the target machine.
+func (cs ClientState) VerifyClientState(
+	cdc *codec.Codec,
+	provingRoot commitmentexported.Root,
+	height uint64,
+	counterpartyClientIdentifier string,
+	prefix commitmentexported.Prefix,
+	proof commitmentexported.Proof,
+	clientState clientexported.ClientState,
+) error {
+	clientPrefixedPath := "clients/" + counterpartyClientIdentifier + "/" + ibctypes.ClientStatePath()
+	path, err := commitmenttypes.ApplyPrefix(prefix, clientPrefixedPath)
+	if err != nil {
+		return err
+	}
+
+	if err := validateClientAndProof(cs, height, proof); err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalBinaryBare(clientState)
+	if err != nil {
+		return err
+	}
+
+	if err := proof.VerifyMembership(provingRoot, path, bz); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedClientStateVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyUpgrade verifies the upgraded client and consensus states, and that
+// they were correctly committed to the counterparty's upgrade path at the
+// last height this client trusts, allowing the client to be upgraded across
+// a hard fork without waiting to collect evidence over the trusting period.
+func (cs ClientState) VerifyUpgrade(
+	cdc *codec.Codec,
+	height uint64,
+	upgradedClient clientexported.ClientState,
+	upgradedConsState clientexported.ConsensusState,
+	proofUpgradeClient,
+	proofUpgradeConsState commitmentexported.Proof,
+	root commitmentexported.Root,
+) error {
+	if len(cs.UpgradePath) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade client, no upgrade path set")
+	}
+
+	if cs.IsFrozen() {
+		return clienttypes.ErrClientFrozen
+	}
+
+	// the upgrade must be proven at this client's own latest trusted height;
+	// a proof for any other height is rejected. root is used as passed and
+	// is not compared against any root this client stores.
+	if cs.GetLatestHeight() != height {
+		return sdkerrors.Wrapf(
+			ibctypes.ErrInvalidHeight,
+			"upgrade height (%d) != client's latest trusted height (%d)", height, cs.GetLatestHeight(),
+		)
+	}
+
+	upgradeClientPath := ibctypes.FullClientPath(cs.UpgradePath, ibctypes.ClientStatePath())
+	upgradeConsStatePath := ibctypes.FullClientPath(cs.UpgradePath, ibctypes.ConsensusStatePath(upgradedClient.GetLatestHeight()))
+
+	bzClient, err := cdc.MarshalBinaryBare(upgradedClient)
+	if err != nil {
+		return err
+	}
+
+	if err := proofUpgradeClient.VerifyMembership(root, upgradeClientPath, bzClient); err != nil {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidUpgradeClient, "client state proof failed. Path: %s, err: %s", upgradeClientPath, err)
+	}
+
+	bzConsState, err := cdc.MarshalBinaryBare(upgradedConsState)
+	if err != nil {
+		return err
+	}
+
+	if err := proofUpgradeConsState.VerifyMembership(root, upgradeConsStatePath, bzConsState); err != nil {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidUpgradeClient, "consensus state proof failed. Path: %s, err: %s", upgradeConsStatePath, err)
+	}
+
+	return nil
+}
+
 // VerifyConnectionState verifies a proof of the connection state of the
 // specified connection end stored on the target machine.
 func (cs ClientState) VerifyConnectionState(
@@ -314,13 +444,14 @@ func (cs ClientState) VerifyNextSequenceRecv(
 	return nil
 }
 
-// validateVerificationArgs perfoms the basic checks on the arguments that are
-// shared between the verification functions.
-func validateVerificationArgs(
+// validateClientAndProof performs the height/frozen/proof-nil checks shared
+// by every Verify* method except VerifyUpgrade (which does its own checks),
+// including VerifyClientState, which has no counterparty consensusState to
+// check.
+func validateClientAndProof(
 	cs ClientState,
 	height uint64,
 	proof commitmentexported.Proof,
-	consensusState clientexported.ConsensusState,
 ) error {
 	if cs.GetLatestHeight() < height {
 		return sdkerrors.Wrap(
@@ -337,6 +468,22 @@ func validateVerificationArgs(
 		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proof cannot be empty")
 	}
 
+	return nil
+}
+
+// validateVerificationArgs perfoms the basic checks on the arguments that are
+// shared between the verification functions that also take a counterparty
+// consensusState.
+func validateVerificationArgs(
+	cs ClientState,
+	height uint64,
+	proof commitmentexported.Proof,
+	consensusState clientexported.ConsensusState,
+) error {
+	if err := validateClientAndProof(cs, height, proof); err != nil {
+		return err
+	}
+
 	if consensusState == nil {
 		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "consensus state cannot be empty")
 	}
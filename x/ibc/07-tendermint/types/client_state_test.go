@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+)
+
+func TestClientStateValidate(t *testing.T) {
+	header := types.Header{
+		ChainID: "chainID",
+		Height:  10,
+	}
+
+	testCases := []struct {
+		name           string
+		clientState    types.ClientState
+		expectInErrMsg string
+	}{
+		{
+			"valid client",
+			types.NewClientState("chainID", time.Hour, 2*time.Hour, time.Second, header, nil),
+			"",
+		},
+		{
+			"negative trusting period",
+			types.NewClientState("chainID", -time.Hour, 2*time.Hour, time.Second, header, nil),
+			"trusting period",
+		},
+		{
+			"zero trusting period",
+			types.NewClientState("chainID", 0, 2*time.Hour, time.Second, header, nil),
+			"trusting period",
+		},
+		{
+			"negative unbonding period",
+			types.NewClientState("chainID", time.Hour, -2*time.Hour, time.Second, header, nil),
+			"unbonding period",
+		},
+		{
+			"zero unbonding period",
+			types.NewClientState("chainID", time.Hour, 0, time.Second, header, nil),
+			"unbonding period",
+		},
+		{
+			"negative max clock drift",
+			types.NewClientState("chainID", time.Hour, 2*time.Hour, -time.Second, header, nil),
+			"max clock drift",
+		},
+		{
+			"zero max clock drift",
+			types.NewClientState("chainID", time.Hour, 2*time.Hour, 0, header, nil),
+			"max clock drift",
+		},
+		{
+			"trusting period not smaller than unbonding period",
+			types.NewClientState("chainID", 2*time.Hour, time.Hour, time.Second, header, nil),
+			"trusting period should be < unbonding period",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.clientState.Validate()
+			if tc.expectInErrMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectInErrMsg)
+		})
+	}
+}
+
+// TestClientStateVerifyUpgrade exercises VerifyUpgrade's guard clauses with
+// a client that actually carries a non-empty UpgradePath. Before UpgradePath
+// was threaded through NewClientState/Initialize/InitializeFromMsg, every
+// client in the tree had a nil UpgradePath and VerifyUpgrade rejected every
+// call with "no upgrade path set" before reaching any of the checks below;
+// this pins down that those checks are now reachable.
+//
+// It stops short of the proof-verification branch: the concrete
+// ConsensusState this chunk's codec.go registers is not part of this source
+// chunk, so there is no value this test can hand VerifyUpgrade as
+// upgradedConsState.
+func TestClientStateVerifyUpgrade(t *testing.T) {
+	header := types.Header{ChainID: "chainID", Height: 10}
+
+	noUpgradePath := types.NewClientState("chainID", time.Hour, 2*time.Hour, time.Second, header, nil)
+	err := noUpgradePath.VerifyUpgrade(nil, 10, types.ClientState{}, nil, nil, nil, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no upgrade path set")
+
+	withUpgradePath := types.NewClientState("chainID", time.Hour, 2*time.Hour, time.Second, header, []string{"upgrade", "upgradedClient"})
+
+	frozen := withUpgradePath
+	frozen.FrozenHeight = 5
+	err = frozen.VerifyUpgrade(nil, 10, types.ClientState{}, nil, nil, nil, nil)
+	require.Equal(t, clienttypes.ErrClientFrozen, err)
+
+	err = withUpgradePath.VerifyUpgrade(nil, 9, types.ClientState{}, nil, nil, nil, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "upgrade height")
+}
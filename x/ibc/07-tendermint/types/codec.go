@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// SubModuleCdc is the codec used by the tendermint client sub-module to
+// (de)serialize its concrete ClientState, ConsensusState, Header,
+// Misbehaviour and MsgCreateClient values, e.g. for MsgCreateClient's
+// GetSignBytes.
+var SubModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(SubModuleCdc)
+	clienttypes.RegisterClientType(clientexported.Tendermint, RegisterCodec)
+	clienttypes.RegisterClientStateConstructor(clientexported.Tendermint, func() clientexported.ClientState {
+		return ClientState{}
+	})
+}
+
+// RegisterCodec registers the tendermint light client concrete types on the
+// given codec. It is called for this sub-module's own SubModuleCdc and, via
+// the 02-client registry's RegisterCodec, for any codec that function is
+// called on, so that the caller does not have to import this package's
+// concrete structs directly.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(ClientState{}, "ibc/client/tendermint/ClientState", nil)
+	cdc.RegisterConcrete(ConsensusState{}, "ibc/client/tendermint/ConsensusState", nil)
+	cdc.RegisterConcrete(Header{}, "ibc/client/tendermint/Header", nil)
+	cdc.RegisterConcrete(Misbehaviour{}, "ibc/client/tendermint/Misbehaviour", nil)
+	cdc.RegisterConcrete(MsgCreateClient{}, "ibc/client/tendermint/MsgCreateClient", nil)
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SubModuleName is the tendermint light client sub-module's name.
+const SubModuleName = "client-tendermint"
+
+// IBC tendermint client sentinel errors
+var (
+	ErrInvalidChainID         = sdkerrors.Register(SubModuleName, 2, "invalid chain-id")
+	ErrInvalidHeaderHeight    = sdkerrors.Register(SubModuleName, 3, "invalid header height")
+	ErrInvalidTrustingPeriod  = sdkerrors.Register(SubModuleName, 4, "invalid trusting period")
+	ErrInvalidUnbondingPeriod = sdkerrors.Register(SubModuleName, 5, "invalid unbonding period")
+	ErrInvalidMaxClockDrift   = sdkerrors.Register(SubModuleName, 6, "invalid max clock drift")
+	ErrInvalidMisbehaviour    = sdkerrors.Register(SubModuleName, 7, "invalid misbehaviour")
+	ErrInvalidClientID        = sdkerrors.Register(SubModuleName, 8, "invalid client id")
+)
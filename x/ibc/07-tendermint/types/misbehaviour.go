@@ -0,0 +1,144 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+var _ clientexported.Misbehaviour = Misbehaviour{}
+
+// Misbehaviour is a wrapper over two conflicting Headers at the same height,
+// proving that the client's currently trusted validator set double-signed
+// (equivocation). Headers signed by any other validator set, e.g. one
+// belonging to a lunatic attack, are rejected rather than treated as
+// misbehaviour; see CheckMisbehaviourAndUpdateState. ClientID is carried
+// explicitly, rather than derived from the headers, since two clients can
+// track the same chain-id and a shared Header alone would not say which of
+// them the conflicting commits belong to.
+//
+// This is only a half-measure against an implicit client id: ClientID is an
+// explicit field here and CheckMisbehaviourAndUpdateState checks it against
+// the receiving client, but clientexported.Misbehaviour still requires
+// GetClientID(), so Misbehaviour still implements it below, and there is no
+// MsgSubmitMisbehaviour or CLI in this source tree to require the submitter
+// to pass client-id as its own argument rather than reading it off the
+// evidence. Dropping GetClientID() from the interface and wiring an
+// explicit client-id through a message and CLI command is a keeper/CLI-layer
+// change this tree does not carry (see the comment on clientTypeCodecs in
+// client_type_registry.go for the same gap on the 02-client side).
+type Misbehaviour struct {
+	ClientID string `json:"client_id" yaml:"client_id"`
+	Header1  Header `json:"header1" yaml:"header1"`
+	Header2  Header `json:"header2" yaml:"header2"`
+}
+
+// ClientType is tendermint.
+func (misbehaviour Misbehaviour) ClientType() clientexported.ClientType {
+	return clientexported.Tendermint
+}
+
+// GetClientID returns the ID of the client that committed the misbehaviour.
+// Still required by clientexported.Misbehaviour; see the scope note above.
+func (misbehaviour Misbehaviour) GetClientID() string {
+	return misbehaviour.ClientID
+}
+
+// GetHeight returns the height at which the misbehaviour occurred, i.e. the
+// (equal) height of the two conflicting headers.
+func (misbehaviour Misbehaviour) GetHeight() uint64 {
+	return uint64(misbehaviour.Header1.Height)
+}
+
+// ValidateBasic checks that the two headers actually conflict: they must
+// share a client id, chain id and height, but commit to different block
+// hashes, otherwise the "evidence" does not demonstrate a fork at all.
+func (misbehaviour Misbehaviour) ValidateBasic() error {
+	if misbehaviour.ClientID == "" {
+		return sdkerrors.Wrap(ErrInvalidMisbehaviour, "client id cannot be empty")
+	}
+	if misbehaviour.Header1.ChainID != misbehaviour.Header2.ChainID {
+		return sdkerrors.Wrap(ErrInvalidMisbehaviour, "headers must have identical chain-ids")
+	}
+	if misbehaviour.Header1.Height != misbehaviour.Header2.Height {
+		return sdkerrors.Wrap(ErrInvalidMisbehaviour, "headers must have identical heights")
+	}
+	if bytes.Equal(misbehaviour.Header1.Commit.Hash(), misbehaviour.Header2.Commit.Hash()) {
+		return sdkerrors.Wrap(ErrInvalidMisbehaviour, "headers must have conflicting commits")
+	}
+
+	return nil
+}
+
+// CheckMisbehaviourAndUpdateState checks that misbehaviour is valid evidence
+// of equivocation by this client's currently trusted validator set within
+// the trusting period, and if so freezes the client at the misbehaviour
+// height, not a sentinel height, so that any proof at or after the
+// compromised height is rejected rather than only proofs after an arbitrary
+// marker. Headers signed by a different validator set (e.g. a lunatic
+// attack against a validator set this client does not yet trust) are
+// rejected rather than accepted as misbehaviour against this client.
+func (cs ClientState) CheckMisbehaviourAndUpdateState(
+	ctx sdk.Context,
+	cdc *codec.Codec,
+	store sdk.KVStore,
+	misbehaviour clientexported.Misbehaviour,
+) (clientexported.ClientState, error) {
+	tmMisbehaviour, ok := misbehaviour.(Misbehaviour)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidMisbehaviour, "misbehaviour type %T, expected %T", misbehaviour, Misbehaviour{})
+	}
+
+	if tmMisbehaviour.ClientID != cs.ID {
+		return nil, sdkerrors.Wrapf(ErrInvalidMisbehaviour, "client id mismatch: got %s, expected %s", tmMisbehaviour.ClientID, cs.ID)
+	}
+
+	if err := tmMisbehaviour.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if cs.IsFrozen() {
+		return nil, clienttypes.ErrClientFrozen
+	}
+
+	if cs.GetLatestTimestamp().Sub(tmMisbehaviour.Header1.Time) > cs.TrustingPeriod {
+		return nil, sdkerrors.Wrap(ErrInvalidMisbehaviour, "misbehaviour headers are outside the trusting period")
+	}
+
+	// Both headers must be signed by the validator set this client currently
+	// trusts: a header signed by some other (e.g. stale or attacker-chosen)
+	// validator set is a lunatic attack, not evidence against this client.
+	trustedHash := cs.LastHeader.ValidatorSet.Hash()
+	if !bytes.Equal(tmMisbehaviour.Header1.ValidatorSet.Hash(), trustedHash) ||
+		!bytes.Equal(tmMisbehaviour.Header2.ValidatorSet.Hash(), trustedHash) {
+		return nil, sdkerrors.Wrap(ErrInvalidMisbehaviour, "misbehaviour headers were not signed by this client's trusted validator set")
+	}
+
+	// Each header must carry a commit with >2/3 voting power from its own
+	// attached validator set, i.e. both conflicting blocks must be genuine,
+	// fully-signed blocks and not merely fabricated hashes.
+	if err := verifyCommitAgainstValidatorSet(tmMisbehaviour.Header1); err != nil {
+		return nil, sdkerrors.Wrap(err, "header1 failed validator set verification")
+	}
+	if err := verifyCommitAgainstValidatorSet(tmMisbehaviour.Header2); err != nil {
+		return nil, sdkerrors.Wrap(err, "header2 failed validator set verification")
+	}
+
+	cs.FrozenHeight = tmMisbehaviour.GetHeight()
+
+	return cs, nil
+}
+
+// verifyCommitAgainstValidatorSet checks that header.Commit is signed by
+// more than 2/3 of the voting power of header.ValidatorSet.
+func verifyCommitAgainstValidatorSet(header Header) error {
+	if header.ValidatorSet == nil || header.Commit == nil {
+		return sdkerrors.Wrap(ErrInvalidMisbehaviour, "header is missing its validator set or commit")
+	}
+
+	return header.ValidatorSet.VerifyCommit(header.ChainID, header.Commit.BlockID, header.Height, header.Commit)
+}
@@ -0,0 +1,165 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmed25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+)
+
+func TestMisbehaviourValidateBasic(t *testing.T) {
+	header1 := types.Header{
+		ChainID: "chainID",
+		Height:  10,
+		Commit:  &tmtypes.Commit{},
+	}
+	header2 := header1
+	header2.Commit = &tmtypes.Commit{Signatures: []tmtypes.CommitSig{{}}}
+
+	testCases := []struct {
+		name         string
+		misbehaviour types.Misbehaviour
+		expectPass   bool
+	}{
+		{
+			"valid equivocation evidence",
+			types.Misbehaviour{ClientID: "clientA", Header1: header1, Header2: header2},
+			true,
+		},
+		{
+			"empty client id",
+			types.Misbehaviour{ClientID: "", Header1: header1, Header2: header2},
+			false,
+		},
+		{
+			"mismatched chain-ids",
+			types.Misbehaviour{
+				ClientID: "clientA",
+				Header1:  header1,
+				Header2:  types.Header{ChainID: "other-chain", Height: 10, Commit: header2.Commit},
+			},
+			false,
+		},
+		{
+			"mismatched heights",
+			types.Misbehaviour{
+				ClientID: "clientA",
+				Header1:  header1,
+				Header2:  types.Header{ChainID: "chainID", Height: 11, Commit: header2.Commit},
+			},
+			false,
+		},
+		{
+			"headers commit to the same block (not a conflict)",
+			types.Misbehaviour{ClientID: "clientA", Header1: header1, Header2: header1},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.misbehaviour.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// TestCheckMisbehaviourAndUpdateStateEquivocation checks the green path: two
+// conflicting headers, both actually signed by more than 2/3 of the voting
+// power of the client's currently trusted validator set, are accepted as
+// equivocation evidence and freeze the client at the misbehaviour height.
+// The very first version of this method shipped with no validator set
+// verification at all, so this guards against that regressing silently.
+func TestCheckMisbehaviourAndUpdateStateEquivocation(t *testing.T) {
+	privVal := tmtypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 10)})
+
+	const (
+		chainID = "chainID"
+		height  = int64(10)
+	)
+	now := time.Now()
+
+	makeHeader := func(blockHash []byte) types.Header {
+		blockID := tmtypes.BlockID{Hash: blockHash, PartSetHeader: tmtypes.PartSetHeader{}}
+
+		voteSet := tmtypes.NewVoteSet(chainID, height, 0, tmtypes.PrecommitType, valSet)
+		commit, err := tmtypes.MakeCommit(blockID, height, 0, voteSet, []tmtypes.PrivValidator{privVal}, now)
+		require.NoError(t, err)
+
+		return types.Header{
+			ChainID:      chainID,
+			Height:       height,
+			Time:         now,
+			ValidatorSet: valSet,
+			Commit:       commit,
+		}
+	}
+
+	header1 := makeHeader([]byte("blockhashA"))
+	header2 := makeHeader([]byte("blockhashB"))
+
+	cs := types.NewClientState(
+		"clientA", time.Hour, 2*time.Hour, time.Second,
+		types.Header{ChainID: chainID, Height: height, Time: now, ValidatorSet: valSet},
+		nil,
+	)
+
+	misbehaviour := types.Misbehaviour{ClientID: "clientA", Header1: header1, Header2: header2}
+
+	newClientState, err := cs.CheckMisbehaviourAndUpdateState(sdk.Context{}, nil, nil, misbehaviour)
+	require.NoError(t, err)
+
+	tmClientState, ok := newClientState.(types.ClientState)
+	require.True(t, ok)
+	require.True(t, tmClientState.IsFrozen())
+	require.Equal(t, uint64(height), tmClientState.FrozenHeight)
+}
+
+// TestCheckMisbehaviourAndUpdateStateLunaticAttack checks that headers
+// signed by a validator set other than the one this client currently trusts
+// are rejected, even though they otherwise look like valid equivocation
+// evidence (same client id, chain-id, height, conflicting commits): this is
+// the lunatic attack case, where an attacker forks off a validator set the
+// client never actually trusted.
+func TestCheckMisbehaviourAndUpdateStateLunaticAttack(t *testing.T) {
+	trustedValSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{
+		tmtypes.NewValidator(tmed25519.GenPrivKey().PubKey(), 10),
+	})
+	attackerValSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{
+		tmtypes.NewValidator(tmed25519.GenPrivKey().PubKey(), 10),
+	})
+
+	now := time.Now()
+	cs := types.NewClientState(
+		"clientA", time.Hour, 2*time.Hour, time.Second,
+		types.Header{ChainID: "chainID", Height: 10, Time: now, ValidatorSet: trustedValSet},
+		nil,
+	)
+
+	header1 := types.Header{
+		ChainID: "chainID", Height: 10, Time: now,
+		ValidatorSet: attackerValSet, Commit: &tmtypes.Commit{},
+	}
+	header2 := header1
+	header2.Commit = &tmtypes.Commit{Signatures: []tmtypes.CommitSig{{}}}
+
+	misbehaviour := types.Misbehaviour{ClientID: "clientA", Header1: header1, Header2: header2}
+
+	_, err := cs.CheckMisbehaviourAndUpdateState(sdk.Context{}, nil, nil, misbehaviour)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "trusted validator set")
+}
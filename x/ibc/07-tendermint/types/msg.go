@@ -0,0 +1,84 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// TypeMsgCreateClient is the type name of MsgCreateClient.
+const TypeMsgCreateClient = "create_client"
+
+var _ sdk.Msg = MsgCreateClient{}
+
+// MsgCreateClient defines a message to create a tendermint client.
+type MsgCreateClient struct {
+	ClientID        string         `json:"client_id" yaml:"client_id"`
+	Header          Header         `json:"header" yaml:"header"`
+	TrustingPeriod  time.Duration  `json:"trusting_period" yaml:"trusting_period"`
+	UnbondingPeriod time.Duration  `json:"unbonding_period" yaml:"unbonding_period"`
+	MaxClockDrift   time.Duration  `json:"max_clock_drift" yaml:"max_clock_drift"`
+	UpgradePath     []string       `json:"upgrade_path" yaml:"upgrade_path"`
+	Signer          sdk.AccAddress `json:"signer" yaml:"signer"`
+}
+
+// NewMsgCreateClient creates a new MsgCreateClient instance
+func NewMsgCreateClient(
+	id string, header Header, trustingPeriod, ubdPeriod, maxClockDrift time.Duration,
+	upgradePath []string, signer sdk.AccAddress,
+) MsgCreateClient {
+	return MsgCreateClient{
+		ClientID:        id,
+		Header:          header,
+		TrustingPeriod:  trustingPeriod,
+		UnbondingPeriod: ubdPeriod,
+		MaxClockDrift:   maxClockDrift,
+		UpgradePath:     upgradePath,
+		Signer:          signer,
+	}
+}
+
+// GetClientID implements the same accessor InitializeFromMsg already relies
+// on.
+func (msg MsgCreateClient) GetClientID() string {
+	return msg.ClientID
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateClient) Route() string {
+	return ibctypes.RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgCreateClient) Type() string {
+	return TypeMsgCreateClient
+}
+
+// ValidateBasic implements sdk.Msg. It runs the same checks as
+// ClientState.Validate against the client state the message would create,
+// so that a client with e.g. TrustingPeriod=-1s is rejected by the ante
+// handler instead of being accepted and only failing later during
+// verification.
+func (msg MsgCreateClient) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if msg.ClientID == "" {
+		return sdkerrors.Wrap(ErrInvalidClientID, "client id cannot be empty")
+	}
+
+	clientState := NewClientState(msg.ClientID, msg.TrustingPeriod, msg.UnbondingPeriod, msg.MaxClockDrift, msg.Header, msg.UpgradePath)
+	return clientState.Validate()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+)
+
+func TestMsgCreateClientValidateBasic(t *testing.T) {
+	header := types.Header{
+		ChainID: "chainID",
+		Height:  10,
+	}
+	signer := sdk.AccAddress("testaddr1")
+
+	testCases := []struct {
+		name           string
+		msg            types.MsgCreateClient
+		expectInErrMsg string
+	}{
+		{
+			"valid msg",
+			types.NewMsgCreateClient("clientA", header, time.Hour, 2*time.Hour, time.Second, nil, signer),
+			"",
+		},
+		{
+			"empty signer",
+			types.NewMsgCreateClient("clientA", header, time.Hour, 2*time.Hour, time.Second, nil, sdk.AccAddress{}),
+			"invalid address",
+		},
+		{
+			"empty client id",
+			types.NewMsgCreateClient("", header, time.Hour, 2*time.Hour, time.Second, nil, signer),
+			"client id",
+		},
+		{
+			"negative trusting period",
+			types.NewMsgCreateClient("clientA", header, -time.Hour, 2*time.Hour, time.Second, nil, signer),
+			"trusting period",
+		},
+		{
+			"trusting period not smaller than unbonding period",
+			types.NewMsgCreateClient("clientA", header, 2*time.Hour, time.Hour, time.Second, nil, signer),
+			"trusting period should be < unbonding period",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectInErrMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectInErrMsg)
+		})
+	}
+}